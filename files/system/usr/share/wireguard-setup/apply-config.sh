@@ -142,6 +142,19 @@ replace_placeholder "[LAPTOP_PUBLIC_KEY]" "$WG_PEER_LAPTOP_PUBLIC_KEY"
 replace_placeholder "[LAPTOP_PRESHARED_KEY]" "$WG_PEER_LAPTOP_PRESHARED_KEY"
 replace_placeholder "[OUTBOUND_INTERFACE]" "$WG_OUTBOUND_INTERFACE"
 
+# WG_SERVER_MTU is optional; the template's commented MTU line is enabled only when it is set
+if [ -n "${WG_SERVER_MTU:-}" ]; then
+    if [[ ! "$WG_SERVER_MTU" =~ ^[0-9]{1,5}$ ]] || (( 10#$WG_SERVER_MTU < 1280 || 10#$WG_SERVER_MTU > 1500 )); then
+        echo -e "${RED}Error: WG_SERVER_MTU must be a number between 1280 and 1500${NC}" >&2
+        exit 1
+    fi
+    if ! grep -Fqx "#MTU=[SERVER_MTU]" "$TEMP_FILE"; then
+        echo -e "${RED}Error: WG_SERVER_MTU is set but the template has no #MTU=[SERVER_MTU] line${NC}" >&2
+        exit 1
+    fi
+    replace_placeholder "#MTU=[SERVER_MTU]" "MTU=$((10#$WG_SERVER_MTU))"
+fi
+
 if grep -Eo '\[[A-Z_]*KEY[A-Z_]*\]' "$TEMP_FILE" | grep -q "^\["; then
     echo -e "${RED}Error: Unresolved placeholders remain after substitution${NC}" >&2
     rm -f "$TEMP_FILE"
@@ -11,6 +11,10 @@ Options:
   --endpoint <host:port>   Required. Public endpoint for the WireGuard server.
   --allowed-ips <cidrs>    Comma-separated AllowedIPs for the peer. Default: 10.253.0.0/24
   --dns <resolver>         Optional DNS server pushed to clients (e.g., 1.1.1.1).
+  --dns-search <domains>   Comma-separated search domains added to DNS (e.g., lan,home.arpa).
+                           Requires --dns. The resolver must be reachable inside --allowed-ips.
+  --mtu <bytes>            Optional interface MTU for clients (1280-1500).
+  --keepalive <seconds>    PersistentKeepalive interval; 0 omits it. Default: 30
   --output-dir <path>      Directory to write client configs. Default: ./peer-configs
   --help                   Show this message and exit.
 USAGE
@@ -23,6 +27,9 @@ DEFAULT_OUTPUT_DIR="$SCRIPT_DIR/peer-configs"
 ENDPOINT=""
 ALLOWED_IPS="10.253.0.0/24"
 DNS=""
+DNS_SEARCH=""
+MTU=""
+KEEPALIVE="30"
 OUTPUT_DIR="$DEFAULT_OUTPUT_DIR"
 
 while [[ $# -gt 0 ]]; do
@@ -42,6 +49,21 @@ while [[ $# -gt 0 ]]; do
             DNS="$2"
             shift 2
             ;;
+        --dns-search)
+            [[ $# -lt 2 ]] && { echo "Error: --dns-search requires a value" >&2; exit 1; }
+            DNS_SEARCH="$2"
+            shift 2
+            ;;
+        --mtu)
+            [[ $# -lt 2 ]] && { echo "Error: --mtu requires a value" >&2; exit 1; }
+            MTU="$2"
+            shift 2
+            ;;
+        --keepalive)
+            [[ $# -lt 2 ]] && { echo "Error: --keepalive requires a value" >&2; exit 1; }
+            KEEPALIVE="$2"
+            shift 2
+            ;;
         --output-dir)
             [[ $# -lt 2 ]] && { echo "Error: --output-dir requires a value" >&2; exit 1; }
             OUTPUT_DIR="$2"
@@ -65,6 +87,52 @@ if [[ -z "$ENDPOINT" ]]; then
     exit 1
 fi
 
+if [[ -n "$DNS_SEARCH" ]]; then
+    if [[ -z "$DNS" ]]; then
+        echo "Error: --dns-search requires --dns" >&2
+        exit 1
+    fi
+    # wg-quick treats IP entries in DNS= as resolvers, so every element must be a
+    # real domain name: dot-separated labels with at least one letter
+    domain_label='[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?'
+    domain_pattern="^${domain_label}(\\.${domain_label})*$"
+    if [[ "$DNS_SEARCH" == ,* || "$DNS_SEARCH" == *, || "$DNS_SEARCH" == *,,* ]]; then
+        echo "Error: --dns-search must be a comma-separated list of domain names" >&2
+        exit 1
+    fi
+    IFS=',' read -r -a search_domains <<< "$DNS_SEARCH"
+    for domain in "${search_domains[@]}"; do
+        if [[ ! "$domain" =~ $domain_pattern || ! "$domain" =~ [A-Za-z] ]]; then
+            echo "Error: --dns-search entry '$domain' is not a domain name (IP addresses belong in --dns)" >&2
+            exit 1
+        fi
+    done
+    DNS="$DNS,$DNS_SEARCH"
+fi
+
+# Numbers are normalized to base 10 so leading zeros are not read as octal
+if [[ -n "$MTU" ]]; then
+    if [[ ! "$MTU" =~ ^[0-9]{1,5}$ ]]; then
+        echo "Error: --mtu must be a number between 1280 and 1500" >&2
+        exit 1
+    fi
+    MTU=$((10#$MTU))
+    if (( MTU < 1280 || MTU > 1500 )); then
+        echo "Error: --mtu must be a number between 1280 and 1500" >&2
+        exit 1
+    fi
+fi
+
+if [[ ! "$KEEPALIVE" =~ ^[0-9]{1,5}$ ]]; then
+    echo "Error: --keepalive must be a number of seconds between 0 and 65535" >&2
+    exit 1
+fi
+KEEPALIVE=$((10#$KEEPALIVE))
+if (( KEEPALIVE > 65535 )); then
+    echo "Error: --keepalive must be a number of seconds between 0 and 65535" >&2
+    exit 1
+fi
+
 if [[ ! -d "$KEYS_DIR" ]]; then
     echo "Error: $KEYS_DIR directory not found. Run ./generate-keys.sh first." >&2
     exit 1
@@ -128,13 +196,16 @@ for peer in "${PEER_ORDER[@]}"; do
         echo "PrivateKey=$peer_private_key"
         echo "Address=$peer_address"
         [[ -n "$DNS" ]] && echo "DNS=$DNS"
+        [[ -n "$MTU" ]] && echo "MTU=$MTU"
         echo
         echo "[Peer]"
         echo "PublicKey=$SERVER_PUBLIC_KEY"
         echo "PresharedKey=$peer_preshared_key"
         echo "Endpoint=$ENDPOINT"
         echo "AllowedIPs=$ALLOWED_IPS"
-        echo "PersistentKeepalive=30"
+        if (( KEEPALIVE > 0 )); then
+            echo "PersistentKeepalive=$KEEPALIVE"
+        fi
     } > "$output_file"
 
 done
@@ -94,6 +94,9 @@ WG_OUTBOUND_INTERFACE=${OUTBOUND_INTERFACE_VALUE}
 # Server private key
 WG_SERVER_PRIVATE_KEY=$(cat "$KEYS_DIR/server-private.key")
 
+# Optional server interface MTU (1280-1500). Leave empty for the wg-quick default.
+WG_SERVER_MTU=
+
 # Peer: LAN-Desktop-Justin
 WG_PEER_DESKTOP_PUBLIC_KEY=$(cat "$KEYS_DIR/desktop-public.key")
 WG_PEER_DESKTOP_PRESHARED_KEY=$(cat "$KEYS_DIR/desktop-preshared.key")